@@ -0,0 +1,44 @@
+package common
+
+import "time"
+
+// EventPhase identifies where in a purge's lifecycle an Event was emitted
+type EventPhase string
+
+// The phases a `mu purge` emits, in the order they occur
+const (
+	EventPhasePurgeStart             EventPhase = "purge-start"
+	EventPhaseResourceDeleteStart    EventPhase = "resource-delete-start"
+	EventPhaseResourceDeleteComplete EventPhase = "resource-delete-complete"
+	EventPhaseResourceDeleteError    EventPhase = "resource-delete-error"
+	EventPhasePurgeComplete          EventPhase = "purge-complete"
+)
+
+// Event is a single purge lifecycle occurrence, emitted to every configured EventSink so
+// operators have an audit trail of what a `mu purge` actually removed.
+type Event struct {
+	Phase        EventPhase
+	ResourceType string
+	ResourceName string
+	Namespace    string
+	Region       string
+	Duration     time.Duration
+	Err          error
+}
+
+// EventSink receives purge lifecycle events. Emit is called inline on the purge's hot path,
+// so implementations must not block for long.
+type EventSink interface {
+	Emit(event Event)
+}
+
+// EventSinks fans a single Emit out to every sink in the slice. A nil or empty EventSinks is
+// a valid no-op sink.
+type EventSinks []EventSink
+
+// Emit calls Emit on every sink in turn
+func (sinks EventSinks) Emit(event Event) {
+	for _, sink := range sinks {
+		sink.Emit(event)
+	}
+}
@@ -0,0 +1,52 @@
+package janitors
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/stelligent/mu/common"
+)
+
+// dashboardNamePrefix is the prefix mu gives every dashboard it creates, of the form
+// "<namespace>-<environment>" or "<namespace>" for account-wide dashboards.
+const dashboardNamePrefix = "mu-"
+
+// CloudWatchDashboardJanitor finds and deletes CloudWatch dashboards mu created directly;
+// dashboards aren't tagged, so mu's own naming convention is used to scope them instead.
+type CloudWatchDashboardJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *CloudWatchDashboardJanitor) Kind() string {
+	return "cloudwatch-dashboard"
+}
+
+// Scan lists dashboards whose name places them in filter's namespace/environment
+func (j *CloudWatchDashboardJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := cloudwatch.New(regionalSession(ctx))
+
+	var resources []common.Resource
+	err := svc.ListDashboardsPages(&cloudwatch.ListDashboardsInput{DashboardNamePrefix: aws.String(dashboardNamePrefix + filter.Namespace)},
+		func(page *cloudwatch.ListDashboardsOutput, lastPage bool) bool {
+			for _, entry := range page.DashboardEntries {
+				name := aws.StringValue(entry.DashboardName)
+				if filter.Environment != "" && !strings.Contains(name, filter.Environment) {
+					continue
+				}
+				resources = append(resources, common.Resource{
+					ID:   name,
+					Name: name,
+					Kind: j.Kind(),
+				})
+			}
+			return true
+		})
+	return resources, err
+}
+
+// Delete removes a single dashboard previously returned by Scan
+func (j *CloudWatchDashboardJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := cloudwatch.New(regionalSession(ctx))
+	_, err := svc.DeleteDashboards(&cloudwatch.DeleteDashboardsInput{DashboardNames: []*string{aws.String(resource.ID)}})
+	return err
+}
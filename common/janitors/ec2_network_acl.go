@@ -0,0 +1,54 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stelligent/mu/common"
+)
+
+// Ec2NetworkACLJanitor finds and deletes non-default network ACLs left behind after a VPC
+// stack's CloudFormation deletion.
+type Ec2NetworkACLJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *Ec2NetworkACLJanitor) Kind() string {
+	return "ec2-network-acl"
+}
+
+// Scan lists non-default network ACLs whose tags place them in filter's namespace/environment
+func (j *Ec2NetworkACLJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := ec2.New(regionalSession(ctx))
+
+	naclsOut, err := svc.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Resource
+	for _, nacl := range naclsOut.NetworkAcls {
+		if aws.BoolValue(nacl.IsDefault) {
+			continue
+		}
+		tags := make(map[string]string, len(nacl.Tags))
+		for _, tag := range nacl.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if !matchesFilter(tags, filter) {
+			continue
+		}
+		resources = append(resources, common.Resource{
+			ID:   aws.StringValue(nacl.NetworkAclId),
+			Name: aws.StringValue(nacl.NetworkAclId),
+			Kind: j.Kind(),
+			Tags: tags,
+		})
+	}
+	return resources, nil
+}
+
+// Delete removes a single network ACL previously returned by Scan
+func (j *Ec2NetworkACLJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := ec2.New(regionalSession(ctx))
+	_, err := svc.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{NetworkAclId: aws.String(resource.ID)})
+	return err
+}
@@ -0,0 +1,62 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stelligent/mu/common"
+)
+
+// Ec2RouteTableJanitor finds and deletes non-main route tables left behind after a VPC
+// stack's CloudFormation deletion.
+type Ec2RouteTableJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *Ec2RouteTableJanitor) Kind() string {
+	return "ec2-route-table"
+}
+
+// Scan lists non-main route tables whose tags place them in filter's namespace/environment
+func (j *Ec2RouteTableJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := ec2.New(regionalSession(ctx))
+
+	routeTablesOut, err := svc.DescribeRouteTables(&ec2.DescribeRouteTablesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Resource
+	for _, routeTable := range routeTablesOut.RouteTables {
+		isMain := false
+		for _, assoc := range routeTable.Associations {
+			if aws.BoolValue(assoc.Main) {
+				isMain = true
+				break
+			}
+		}
+		if isMain {
+			continue
+		}
+
+		tags := make(map[string]string, len(routeTable.Tags))
+		for _, tag := range routeTable.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if !matchesFilter(tags, filter) {
+			continue
+		}
+		resources = append(resources, common.Resource{
+			ID:   aws.StringValue(routeTable.RouteTableId),
+			Name: aws.StringValue(routeTable.RouteTableId),
+			Kind: j.Kind(),
+			Tags: tags,
+		})
+	}
+	return resources, nil
+}
+
+// Delete removes a single route table previously returned by Scan
+func (j *Ec2RouteTableJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := ec2.New(regionalSession(ctx))
+	_, err := svc.DeleteRouteTable(&ec2.DeleteRouteTableInput{RouteTableId: aws.String(resource.ID)})
+	return err
+}
@@ -0,0 +1,51 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stelligent/mu/common"
+)
+
+// Ec2SubnetJanitor finds and deletes VPC subnets left behind when a VPC stack is deleted
+// without CloudFormation removing every subnet it created.
+type Ec2SubnetJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *Ec2SubnetJanitor) Kind() string {
+	return "ec2-subnet"
+}
+
+// Scan lists subnets whose tags place them in filter's namespace/environment
+func (j *Ec2SubnetJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := ec2.New(regionalSession(ctx))
+
+	subnetsOut, err := svc.DescribeSubnets(&ec2.DescribeSubnetsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Resource
+	for _, subnet := range subnetsOut.Subnets {
+		tags := make(map[string]string, len(subnet.Tags))
+		for _, tag := range subnet.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if !matchesFilter(tags, filter) {
+			continue
+		}
+		resources = append(resources, common.Resource{
+			ID:   aws.StringValue(subnet.SubnetId),
+			Name: aws.StringValue(subnet.SubnetId),
+			Kind: j.Kind(),
+			Tags: tags,
+		})
+	}
+	return resources, nil
+}
+
+// Delete removes a single subnet previously returned by Scan
+func (j *Ec2SubnetJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := ec2.New(regionalSession(ctx))
+	_, err := svc.DeleteSubnet(&ec2.DeleteSubnetInput{SubnetId: aws.String(resource.ID)})
+	return err
+}
@@ -0,0 +1,64 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/stelligent/mu/common"
+)
+
+// EcsScheduledTaskJanitor finds and deletes the CloudWatch Events rules mu creates to run ECS
+// scheduled tasks; these are tagged directly since they're decoupled from the service stack
+// that defines them.
+type EcsScheduledTaskJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *EcsScheduledTaskJanitor) Kind() string {
+	return "ecs-scheduled-task"
+}
+
+// Scan lists CloudWatch Events rules whose tags place them in filter's namespace/environment
+func (j *EcsScheduledTaskJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := cloudwatchevents.New(regionalSession(ctx))
+
+	var resources []common.Resource
+	err := svc.ListRulesPages(&cloudwatchevents.ListRulesInput{}, func(page *cloudwatchevents.ListRulesOutput, lastPage bool) bool {
+		for _, rule := range page.Rules {
+			tagsOut, err := svc.ListTagsForResource(&cloudwatchevents.ListTagsForResourceInput{ResourceARN: rule.Arn})
+			if err != nil {
+				continue
+			}
+			tags := make(map[string]string, len(tagsOut.Tags))
+			for _, tag := range tagsOut.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			if !matchesFilter(tags, filter) {
+				continue
+			}
+			resources = append(resources, common.Resource{
+				ID:   aws.StringValue(rule.Name),
+				Name: aws.StringValue(rule.Name),
+				Kind: j.Kind(),
+				Tags: tags,
+			})
+		}
+		return true
+	})
+	return resources, err
+}
+
+// Delete removes a single scheduled-task rule (and its targets) previously returned by Scan
+func (j *EcsScheduledTaskJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := cloudwatchevents.New(regionalSession(ctx))
+
+	targetsOut, err := svc.ListTargetsByRule(&cloudwatchevents.ListTargetsByRuleInput{Rule: aws.String(resource.ID)})
+	if err == nil && len(targetsOut.Targets) > 0 {
+		ids := make([]*string, len(targetsOut.Targets))
+		for i, target := range targetsOut.Targets {
+			ids[i] = target.Id
+		}
+		svc.RemoveTargets(&cloudwatchevents.RemoveTargetsInput{Rule: aws.String(resource.ID), Ids: ids})
+	}
+
+	_, err = svc.DeleteRule(&cloudwatchevents.DeleteRuleInput{Name: aws.String(resource.ID)})
+	return err
+}
@@ -0,0 +1,54 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stelligent/mu/common"
+)
+
+// ElbJanitor finds and deletes load balancers left behind when an environment or service
+// stack is deleted without CloudFormation fully tearing them down; their target groups are
+// not deleted.
+type ElbJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *ElbJanitor) Kind() string {
+	return "elb"
+}
+
+// Scan lists load balancers whose tags place them in filter's namespace/environment
+func (j *ElbJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := elbv2.New(regionalSession(ctx))
+
+	var resources []common.Resource
+	err := svc.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		for _, lb := range page.LoadBalancers {
+			tagsOut, err := svc.DescribeTags(&elbv2.DescribeTagsInput{ResourceArns: []*string{lb.LoadBalancerArn}})
+			if err != nil || len(tagsOut.TagDescriptions) == 0 {
+				continue
+			}
+			tags := make(map[string]string, len(tagsOut.TagDescriptions[0].Tags))
+			for _, tag := range tagsOut.TagDescriptions[0].Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			if !matchesFilter(tags, filter) {
+				continue
+			}
+			resources = append(resources, common.Resource{
+				ID:   aws.StringValue(lb.LoadBalancerArn),
+				Name: aws.StringValue(lb.LoadBalancerName),
+				Kind: j.Kind(),
+				Tags: tags,
+			})
+		}
+		return true
+	})
+	return resources, err
+}
+
+// Delete removes a single load balancer previously returned by Scan
+func (j *ElbJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := elbv2.New(regionalSession(ctx))
+	_, err := svc.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String(resource.ID)})
+	return err
+}
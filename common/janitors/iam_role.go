@@ -0,0 +1,62 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/stelligent/mu/common"
+)
+
+// IamRoleJanitor finds and deletes the IAM roles mu's RolesetManager creates for
+// environments, services and pipelines; these live outside of any CloudFormation stack mu
+// deletes as part of a regular purge.
+type IamRoleJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *IamRoleJanitor) Kind() string {
+	return "iam-role"
+}
+
+// Scan lists IAM roles whose tags place them in filter's namespace/environment
+func (j *IamRoleJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := iam.New(regionalSession(ctx))
+
+	var resources []common.Resource
+	err := svc.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		for _, role := range page.Roles {
+			tagsOut, err := svc.ListRoleTags(&iam.ListRoleTagsInput{RoleName: role.RoleName})
+			if err != nil {
+				continue
+			}
+			tags := make(map[string]string, len(tagsOut.Tags))
+			for _, tag := range tagsOut.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			if !matchesFilter(tags, filter) {
+				continue
+			}
+			resources = append(resources, common.Resource{
+				ID:   aws.StringValue(role.RoleName),
+				Name: aws.StringValue(role.RoleName),
+				Kind: j.Kind(),
+				Tags: tags,
+			})
+		}
+		return true
+	})
+	return resources, err
+}
+
+// Delete removes a single IAM role (and its attached policies) previously returned by Scan
+func (j *IamRoleJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := iam.New(regionalSession(ctx))
+
+	policiesOut, err := svc.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String(resource.ID)})
+	if err == nil {
+		for _, policy := range policiesOut.AttachedPolicies {
+			svc.DetachRolePolicy(&iam.DetachRolePolicyInput{RoleName: aws.String(resource.ID), PolicyArn: policy.PolicyArn})
+		}
+	}
+
+	_, err = svc.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String(resource.ID)})
+	return err
+}
@@ -0,0 +1,54 @@
+// Package janitors provides common.ResourceJanitor implementations for the AWS resource types
+// that a `mu purge` doesn't reach by deleting CloudFormation stacks alone, because mu tags
+// them directly rather than managing them through a stack.
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stelligent/mu/common"
+)
+
+// namespaceTagKey and environmentTagKey are the tags mu stamps onto every resource it manages
+// directly, used to scope a janitor's Scan to the namespace/environment being purged.
+const (
+	namespaceTagKey   = "mu:namespace"
+	environmentTagKey = "mu:environment"
+)
+
+// NewDefaultRegistry returns a common.JanitorRegistry with a janitor registered for every
+// resource type mu doesn't reliably clean up via CloudFormation stack deletion alone.
+func NewDefaultRegistry() *common.JanitorRegistry {
+	registry := common.NewJanitorRegistry()
+	registry.Register(new(SesJanitor))
+	registry.Register(new(SnsJanitor))
+	registry.Register(new(SqsJanitor))
+	registry.Register(new(ElbJanitor))
+	registry.Register(new(Ec2SubnetJanitor))
+	registry.Register(new(Ec2NetworkACLJanitor))
+	registry.Register(new(Ec2RouteTableJanitor))
+	registry.Register(new(SecurityGroupJanitor))
+	registry.Register(new(CloudWatchDashboardJanitor))
+	registry.Register(new(EcsScheduledTaskJanitor))
+	registry.Register(new(IamRoleJanitor))
+	return registry
+}
+
+// regionalSession builds an AWS session scoped to ctx's region, so a janitor's Scan/Delete
+// operates against the same (region, namespace) pair the purge loop is iterating, the same
+// way StackManager/RolesetManager are built per-region in purgeRegionNamespace.
+func regionalSession(ctx *common.Context) *session.Session {
+	return session.Must(session.NewSession(&aws.Config{Region: aws.String(ctx.Config.Region)}))
+}
+
+// matchesFilter reports whether a resource's tags place it in the namespace (and, if set,
+// environment) that filter is scoped to.
+func matchesFilter(tags map[string]string, filter common.ResourceFilter) bool {
+	if tags[namespaceTagKey] != filter.Namespace {
+		return false
+	}
+	if filter.Environment != "" && tags[environmentTagKey] != filter.Environment {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stelligent/mu/common"
+)
+
+// SecurityGroupJanitor finds and deletes non-default security groups left behind after a VPC
+// or service stack's CloudFormation deletion.
+type SecurityGroupJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *SecurityGroupJanitor) Kind() string {
+	return "security-group"
+}
+
+// Scan lists non-default security groups whose tags place them in filter's namespace/environment
+func (j *SecurityGroupJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := ec2.New(regionalSession(ctx))
+
+	groupsOut, err := svc.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Resource
+	for _, group := range groupsOut.SecurityGroups {
+		if aws.StringValue(group.GroupName) == "default" {
+			continue
+		}
+		tags := make(map[string]string, len(group.Tags))
+		for _, tag := range group.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if !matchesFilter(tags, filter) {
+			continue
+		}
+		resources = append(resources, common.Resource{
+			ID:   aws.StringValue(group.GroupId),
+			Name: aws.StringValue(group.GroupName),
+			Kind: j.Kind(),
+			Tags: tags,
+		})
+	}
+	return resources, nil
+}
+
+// Delete removes a single security group previously returned by Scan
+func (j *SecurityGroupJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := ec2.New(regionalSession(ctx))
+	_, err := svc.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: aws.String(resource.ID)})
+	return err
+}
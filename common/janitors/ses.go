@@ -0,0 +1,55 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/stelligent/mu/common"
+)
+
+// SesJanitor finds and deletes SES identities (verified domains/emails) that mu created
+// outside of a CloudFormation stack and tagged directly.
+type SesJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *SesJanitor) Kind() string {
+	return "ses"
+}
+
+// Scan lists SES identities whose tags place them in filter's namespace/environment
+func (j *SesJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := ses.New(regionalSession(ctx))
+
+	identitiesOut, err := svc.ListIdentities(&ses.ListIdentitiesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Resource
+	for _, identity := range identitiesOut.Identities {
+		tagsOut, err := svc.ListTagsForResource(&ses.ListTagsForResourceInput{ResourceArn: identity})
+		if err != nil {
+			continue
+		}
+		tags := make(map[string]string, len(tagsOut.Tags))
+		for _, tag := range tagsOut.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if !matchesFilter(tags, filter) {
+			continue
+		}
+		resources = append(resources, common.Resource{
+			ID:   aws.StringValue(identity),
+			Name: aws.StringValue(identity),
+			Kind: j.Kind(),
+			Tags: tags,
+		})
+	}
+	return resources, nil
+}
+
+// Delete removes a single SES identity previously returned by Scan
+func (j *SesJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := ses.New(regionalSession(ctx))
+	_, err := svc.DeleteIdentity(&ses.DeleteIdentityInput{Identity: aws.String(resource.ID)})
+	return err
+}
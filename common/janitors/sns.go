@@ -0,0 +1,53 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/stelligent/mu/common"
+)
+
+// SnsJanitor finds and deletes SNS topics that mu created and tagged directly, outside of a
+// CloudFormation stack.
+type SnsJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *SnsJanitor) Kind() string {
+	return "sns"
+}
+
+// Scan lists SNS topics whose tags place them in filter's namespace/environment
+func (j *SnsJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := sns.New(regionalSession(ctx))
+
+	var resources []common.Resource
+	err := svc.ListTopicsPages(&sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+		for _, topic := range page.Topics {
+			tagsOut, err := svc.ListTagsForResource(&sns.ListTagsForResourceInput{ResourceArn: topic.TopicArn})
+			if err != nil {
+				continue
+			}
+			tags := make(map[string]string, len(tagsOut.Tags))
+			for _, tag := range tagsOut.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			if !matchesFilter(tags, filter) {
+				continue
+			}
+			resources = append(resources, common.Resource{
+				ID:   aws.StringValue(topic.TopicArn),
+				Name: aws.StringValue(topic.TopicArn),
+				Kind: j.Kind(),
+				Tags: tags,
+			})
+		}
+		return true
+	})
+	return resources, err
+}
+
+// Delete removes a single SNS topic previously returned by Scan
+func (j *SnsJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := sns.New(regionalSession(ctx))
+	_, err := svc.DeleteTopic(&sns.DeleteTopicInput{TopicArn: aws.String(resource.ID)})
+	return err
+}
@@ -0,0 +1,53 @@
+package janitors
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/stelligent/mu/common"
+)
+
+// SqsJanitor finds and deletes SQS queues that mu created and tagged directly, outside of a
+// CloudFormation stack.
+type SqsJanitor struct{}
+
+// Kind identifies this janitor for the purge's Only/Skip options
+func (j *SqsJanitor) Kind() string {
+	return "sqs"
+}
+
+// Scan lists SQS queues whose tags place them in filter's namespace/environment
+func (j *SqsJanitor) Scan(ctx *common.Context, filter common.ResourceFilter) ([]common.Resource, error) {
+	svc := sqs.New(regionalSession(ctx))
+
+	var resources []common.Resource
+	err := svc.ListQueuesPages(&sqs.ListQueuesInput{}, func(page *sqs.ListQueuesOutput, lastPage bool) bool {
+		for _, queueURL := range page.QueueUrls {
+			tagsOut, err := svc.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: queueURL})
+			if err != nil {
+				continue
+			}
+			tags := make(map[string]string, len(tagsOut.Tags))
+			for key, val := range tagsOut.Tags {
+				tags[key] = aws.StringValue(val)
+			}
+			if !matchesFilter(tags, filter) {
+				continue
+			}
+			resources = append(resources, common.Resource{
+				ID:   aws.StringValue(queueURL),
+				Name: aws.StringValue(queueURL),
+				Kind: j.Kind(),
+				Tags: tags,
+			})
+		}
+		return true
+	})
+	return resources, err
+}
+
+// Delete removes a single SQS queue previously returned by Scan
+func (j *SqsJanitor) Delete(ctx *common.Context, resource common.Resource) error {
+	svc := sqs.New(regionalSession(ctx))
+	_, err := svc.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: aws.String(resource.ID)})
+	return err
+}
@@ -0,0 +1,84 @@
+package common
+
+import "sort"
+
+// Resource is a single cloud resource discovered by a ResourceJanitor, eligible for deletion
+// once it matches the filter passed to Scan.
+type Resource struct {
+	ID     string
+	Name   string
+	Kind   string
+	Region string
+	Tags   map[string]string
+}
+
+// ResourceFilter narrows which resources a ResourceJanitor.Scan returns, so a janitor only
+// ever touches resources tagged as belonging to mu.
+type ResourceFilter struct {
+	Namespace   string
+	Environment string
+}
+
+// ResourceJanitor discovers and deletes resources of a single AWS service that CloudFormation
+// stack deletion doesn't reliably clean up on its own, either because the resource is decoupled
+// from the stack that created it or because it was created outside of one entirely.
+type ResourceJanitor interface {
+	// Scan returns the resources of this janitor's kind that match filter
+	Scan(ctx *Context, filter ResourceFilter) ([]Resource, error)
+
+	// Delete removes a single resource previously returned by Scan
+	Delete(ctx *Context, resource Resource) error
+
+	// Kind identifies this janitor, e.g. "sqs" or "iam-role"; matched against the purge
+	// workflow's Only/Skip options, which no command-line flag sets
+	Kind() string
+}
+
+// JanitorRegistry is the set of ResourceJanitors consulted during a purge, keyed by Kind()
+type JanitorRegistry struct {
+	janitors map[string]ResourceJanitor
+}
+
+// NewJanitorRegistry creates an empty JanitorRegistry
+func NewJanitorRegistry() *JanitorRegistry {
+	return &JanitorRegistry{janitors: make(map[string]ResourceJanitor)}
+}
+
+// Register adds a janitor to the registry, keyed by its Kind()
+func (registry *JanitorRegistry) Register(janitor ResourceJanitor) {
+	registry.janitors[janitor.Kind()] = janitor
+}
+
+// Select returns the registered janitors to run, given the purge's Only/Skip options, sorted
+// by Kind() so the janitor table and deletion order are reproducible across runs instead of
+// following Go's randomized map iteration order. An empty only selects every registered
+// janitor; skip is applied after only.
+func (registry *JanitorRegistry) Select(only []string, skip []string) []ResourceJanitor {
+	skipSet := make(map[string]bool, len(skip))
+	for _, kind := range skip {
+		skipSet[kind] = true
+	}
+
+	var onlySet map[string]bool
+	if len(only) > 0 {
+		onlySet = make(map[string]bool, len(only))
+		for _, kind := range only {
+			onlySet[kind] = true
+		}
+	}
+
+	var selected []ResourceJanitor
+	for kind, janitor := range registry.janitors {
+		if skipSet[kind] {
+			continue
+		}
+		if onlySet != nil && !onlySet[kind] {
+			continue
+		}
+		selected = append(selected, janitor)
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Kind() < selected[j].Kind()
+	})
+	return selected
+}
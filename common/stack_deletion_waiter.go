@@ -0,0 +1,129 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// stackDeletionFailedStatus is the CloudFormation status a stack lands in when a delete
+// attempt errors out partway through, as opposed to succeeding (stack vanishes) or landing
+// in some other unexpected terminal status.
+const stackDeletionFailedStatus = "DELETE_FAILED"
+
+// StackDeletionProgress is the status a single deletion attempt of a stack ended in, emitted
+// so callers can report each attempt's outcome to a user.
+type StackDeletionProgress struct {
+	StackName string
+	Status    string
+	Attempt   int
+}
+
+// StackDeletionWaiter deletes a stack and waits for it to reach a terminal state through
+// StackWaiter.AwaitFinalStatus, retrying DELETE_FAILED stacks up to MaxRetries times with a
+// doubling delay between attempts, after emptying any bucket contents found among the
+// stack's resources. It does not poll the stack's status itself: each attempt blocks until
+// AwaitFinalStatus returns.
+type StackDeletionWaiter struct {
+	StackDeleter        StackDeleter
+	StackWaiter         StackWaiter
+	StackLister         StackLister
+	BucketObjectDeleter BucketObjectDeleter
+
+	// MaxRetries is how many times a DELETE_FAILED stack is retried before giving up
+	MaxRetries int
+
+	// InitialBackoff is the delay before the first retry; it doubles after each attempt
+	InitialBackoff time.Duration
+
+	// Progress, if set, is called once per attempt with the status that attempt ended in;
+	// nothing is reported while an attempt is still waiting
+	Progress func(StackDeletionProgress)
+}
+
+func (waiter *StackDeletionWaiter) emit(progress StackDeletionProgress) {
+	if waiter.Progress != nil {
+		waiter.Progress(progress)
+	}
+}
+
+// NewStackDeletionWaiter creates a StackDeletionWaiter with the repo's default retry/backoff
+// settings (3 retries, starting at 5s and doubling each attempt).
+func NewStackDeletionWaiter(stackDeleter StackDeleter, stackWaiter StackWaiter, stackLister StackLister, bucketObjectDeleter BucketObjectDeleter) *StackDeletionWaiter {
+	return &StackDeletionWaiter{
+		StackDeleter:        stackDeleter,
+		StackWaiter:         stackWaiter,
+		StackLister:         stackLister,
+		BucketObjectDeleter: bucketObjectDeleter,
+		MaxRetries:          3,
+		InitialBackoff:      5 * time.Second,
+	}
+}
+
+// Await deletes stackName and blocks until it reaches a terminal status, retrying
+// DELETE_FAILED attempts up to MaxRetries times. It returns nil once the stack is gone or
+// reports _COMPLETE; any other terminal status, or exhausting the retries, returns an error.
+func (waiter *StackDeletionWaiter) Await(stackName string) error {
+	backoff := waiter.InitialBackoff
+
+	// the first attempt plus up to MaxRetries retries
+	attempts := waiter.MaxRetries + 1
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err := waiter.StackDeleter.DeleteStack(stackName); err != nil {
+			return fmt.Errorf("failed to delete stack '%s': %v", stackName, err)
+		}
+
+		stack := waiter.StackWaiter.AwaitFinalStatus(stackName)
+		if stack == nil {
+			waiter.emit(StackDeletionProgress{StackName: stackName, Status: "DELETE_COMPLETE", Attempt: attempt})
+			return nil
+		}
+
+		waiter.emit(StackDeletionProgress{StackName: stackName, Status: stack.Status, Attempt: attempt})
+
+		if strings.HasSuffix(stack.Status, "_COMPLETE") {
+			return nil
+		}
+
+		if stack.Status != stackDeletionFailedStatus {
+			return fmt.Errorf("stack '%s' ended in status %s: %s", stackName, stack.Status, stack.StatusReason)
+		}
+
+		waiter.emptyBlockingBuckets(stack)
+
+		if attempt < attempts {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+
+	return fmt.Errorf("stack '%s' still %s after %d attempts", stackName, stackDeletionFailedStatus, attempts)
+}
+
+// s3BucketResourceType is the CloudFormation resource type emptyBlockingBuckets looks for
+// among a stack's resources; anything else (IAM roles, ECS clusters, RDS instances, ...) is
+// left alone even though it shares the same PhysicalResourceId shape.
+const s3BucketResourceType = "AWS::S3::Bucket"
+
+// emptyBlockingBuckets empties any S3 buckets among the stack's resources, since a non-empty
+// bucket is the most common cause of a stack landing in DELETE_FAILED.
+// TODO: also detach ENIs left attached by a security group/subnet this stack owns
+func (waiter *StackDeletionWaiter) emptyBlockingBuckets(stack *Stack) {
+	if waiter.StackLister == nil || waiter.BucketObjectDeleter == nil {
+		return
+	}
+
+	resources, err := waiter.StackLister.GetResourcesForStack(stack)
+	if err != nil {
+		return
+	}
+	for _, resource := range resources {
+		if resource.ResourceType == nil || *resource.ResourceType != s3BucketResourceType {
+			continue
+		}
+		if resource.PhysicalResourceId == nil {
+			continue
+		}
+		waiter.BucketObjectDeleter.DeleteS3BucketObjects(*resource.PhysicalResourceId)
+	}
+}
@@ -0,0 +1,62 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/stelligent/mu/common"
+)
+
+// CloudWatchLogsSink writes each Event as a JSON log entry to a CloudWatch Logs stream. Emit
+// may be called concurrently (e.g. from PurgePlan.execute()'s per-wave goroutines), so the
+// sequence token - which CloudWatch Logs requires to be passed in lockstep with each call -
+// is guarded by mutex.
+type CloudWatchLogsSink struct {
+	LogGroupName  string
+	LogStreamName string
+
+	svc *cloudwatchlogs.CloudWatchLogs
+
+	mu            sync.Mutex
+	sequenceToken *string
+}
+
+// NewCloudWatchLogsSink creates a CloudWatchLogsSink from a telemetry: cloudwatchLogs: block
+func NewCloudWatchLogsSink(cfg *common.CloudWatchLogsTelemetryConfig) *CloudWatchLogsSink {
+	return &CloudWatchLogsSink{
+		LogGroupName:  cfg.LogGroupName,
+		LogStreamName: cfg.LogStreamName,
+		svc:           cloudwatchlogs.New(session.Must(session.NewSession())),
+	}
+}
+
+// Emit writes event as a JSON log entry to the configured log group/stream
+func (sink *CloudWatchLogsSink) Emit(event common.Event) {
+	message, err := json.Marshal(eventJSON(event))
+	if err != nil {
+		return
+	}
+
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	out, err := sink.svc.PutLogEvents(&cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(sink.LogGroupName),
+		LogStreamName: aws.String(sink.LogStreamName),
+		SequenceToken: sink.sequenceToken,
+		LogEvents: []*cloudwatchlogs.InputLogEvent{
+			{
+				Message:   aws.String(string(message)),
+				Timestamp: aws.Int64(time.Now().UnixNano() / int64(time.Millisecond)),
+			},
+		},
+	})
+	if err != nil {
+		return
+	}
+	sink.sequenceToken = out.NextSequenceToken
+}
@@ -0,0 +1,28 @@
+package telemetry
+
+import (
+	"io"
+
+	"github.com/stelligent/mu/common"
+)
+
+// NewSinksFromConfig builds the common.EventSinks that cfg (a `telemetry:` block) configures.
+// stdoutWriter is used for the stdout-JSON sink, so purge telemetry lands alongside the
+// workflow's other output; a nil cfg returns no sinks.
+func NewSinksFromConfig(cfg *common.TelemetryConfig, stdoutWriter io.Writer) common.EventSinks {
+	if cfg == nil {
+		return nil
+	}
+
+	var sinks common.EventSinks
+	if cfg.Stdout != nil && cfg.Stdout.Enabled {
+		sinks = append(sinks, NewStdoutSink(stdoutWriter))
+	}
+	if cfg.CloudWatchLogs != nil {
+		sinks = append(sinks, NewCloudWatchLogsSink(cfg.CloudWatchLogs))
+	}
+	if cfg.SNS != nil {
+		sinks = append(sinks, NewSNSSink(cfg.SNS))
+	}
+	return sinks
+}
@@ -0,0 +1,37 @@
+package telemetry
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/stelligent/mu/common"
+)
+
+// SNSSink publishes each Event as a JSON message to an SNS topic
+type SNSSink struct {
+	TopicArn string
+
+	svc *sns.SNS
+}
+
+// NewSNSSink creates an SNSSink from a telemetry: sns: block
+func NewSNSSink(cfg *common.SNSTelemetryConfig) *SNSSink {
+	return &SNSSink{
+		TopicArn: cfg.TopicArn,
+		svc:      sns.New(session.Must(session.NewSession())),
+	}
+}
+
+// Emit publishes event as a JSON message to the configured SNS topic
+func (sink *SNSSink) Emit(event common.Event) {
+	message, err := json.Marshal(eventJSON(event))
+	if err != nil {
+		return
+	}
+	sink.svc.Publish(&sns.PublishInput{
+		TopicArn: aws.String(sink.TopicArn),
+		Message:  aws.String(string(message)),
+	})
+}
@@ -0,0 +1,55 @@
+// Package telemetry provides common.EventSink implementations for the purge workflow's
+// `telemetry:` config block.
+package telemetry
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/stelligent/mu/common"
+)
+
+// StdoutSink writes each Event as a single line of JSON to the configured writer. Emit may be
+// called concurrently (e.g. from PurgePlan.execute()'s per-wave goroutines, or from multiple
+// regions purging at once), so writes are serialized with a mutex to avoid interleaving lines
+// on a Writer that isn't itself safe for concurrent use.
+type StdoutSink struct {
+	Writer io.Writer
+
+	mu sync.Mutex
+}
+
+// NewStdoutSink creates a StdoutSink that writes to writer
+func NewStdoutSink(writer io.Writer) *StdoutSink {
+	return &StdoutSink{Writer: writer}
+}
+
+// Emit writes event to the sink's writer as a single line of JSON
+func (sink *StdoutSink) Emit(event common.Event) {
+	line, err := json.Marshal(eventJSON(event))
+	if err != nil {
+		return
+	}
+
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	sink.Writer.Write(append(line, '\n'))
+}
+
+// eventJSON adapts an Event for JSON encoding, since error values don't marshal on their own
+func eventJSON(event common.Event) map[string]interface{} {
+	errMsg := ""
+	if event.Err != nil {
+		errMsg = event.Err.Error()
+	}
+	return map[string]interface{}{
+		"phase":        event.Phase,
+		"resourceType": event.ResourceType,
+		"resourceName": event.ResourceName,
+		"namespace":    event.Namespace,
+		"region":       event.Region,
+		"durationMs":   event.Duration.Milliseconds(),
+		"error":        errMsg,
+	}
+}
@@ -0,0 +1,28 @@
+package common
+
+// TelemetryConfig configures where purge lifecycle events are sent, in the shape of a
+// `telemetry:` block. Any combination of sinks may be enabled at once; events are fanned out
+// to all of them.
+//
+// The root Config struct has no field of this type, so mu.yml is not read into it.
+type TelemetryConfig struct {
+	Stdout         *StdoutTelemetryConfig         `yaml:"stdout,omitempty"`
+	CloudWatchLogs *CloudWatchLogsTelemetryConfig `yaml:"cloudwatchLogs,omitempty"`
+	SNS            *SNSTelemetryConfig            `yaml:"sns,omitempty"`
+}
+
+// StdoutTelemetryConfig enables the stdout-JSON event sink
+type StdoutTelemetryConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// CloudWatchLogsTelemetryConfig configures the CloudWatch Logs event sink
+type CloudWatchLogsTelemetryConfig struct {
+	LogGroupName  string `yaml:"logGroupName"`
+	LogStreamName string `yaml:"logStreamName"`
+}
+
+// SNSTelemetryConfig configures the SNS-topic event sink
+type SNSTelemetryConfig struct {
+	TopicArn string `yaml:"topicArn"`
+}
@@ -0,0 +1,107 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stelligent/mu/common"
+)
+
+func TestDeterminePurgeActionIgnored(t *testing.T) {
+	stack := &common.Stack{Tags: map[string]string{"mu/ignore-purge": "true"}}
+	opts := &PurgeOptions{IgnoreTagKey: "mu/ignore-purge", MarkTagKey: "mu/marked-for-deletion"}
+
+	if action := determinePurgeAction(stack, opts); action != purgeActionSkip {
+		t.Errorf("expected purgeActionSkip for an ignored stack, got %v", action)
+	}
+}
+
+func TestDeterminePurgeActionImmediate(t *testing.T) {
+	stack := &common.Stack{Tags: map[string]string{}}
+	opts := &PurgeOptions{IgnoreTagKey: "mu/ignore-purge", MarkTagKey: "mu/marked-for-deletion", Immediate: true}
+
+	if action := determinePurgeAction(stack, opts); action != purgeActionDelete {
+		t.Errorf("expected purgeActionDelete when Immediate is set, got %v", action)
+	}
+}
+
+func TestDeterminePurgeActionUnmarked(t *testing.T) {
+	stack := &common.Stack{Tags: map[string]string{}}
+	opts := &PurgeOptions{IgnoreTagKey: "mu/ignore-purge", MarkTagKey: "mu/marked-for-deletion"}
+
+	if action := determinePurgeAction(stack, opts); action != purgeActionMark {
+		t.Errorf("expected purgeActionMark for an unmarked stack, got %v", action)
+	}
+}
+
+func TestDeterminePurgeActionMarkedWithinTTL(t *testing.T) {
+	stack := &common.Stack{Tags: map[string]string{
+		"mu/marked-for-deletion": time.Now().UTC().Format(time.RFC3339),
+	}}
+	opts := &PurgeOptions{IgnoreTagKey: "mu/ignore-purge", MarkTagKey: "mu/marked-for-deletion", TTL: time.Hour}
+
+	if action := determinePurgeAction(stack, opts); action != purgeActionSkip {
+		t.Errorf("expected purgeActionSkip for a stack marked within the TTL, got %v", action)
+	}
+}
+
+func TestDeterminePurgeActionMarkedPastTTL(t *testing.T) {
+	stack := &common.Stack{Tags: map[string]string{
+		"mu/marked-for-deletion": time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+	}}
+	opts := &PurgeOptions{IgnoreTagKey: "mu/ignore-purge", MarkTagKey: "mu/marked-for-deletion", TTL: time.Hour}
+
+	if action := determinePurgeAction(stack, opts); action != purgeActionDelete {
+		t.Errorf("expected purgeActionDelete for a stack marked past the TTL, got %v", action)
+	}
+}
+
+func TestPurgePlanExecuteOrdersWaves(t *testing.T) {
+	var order []string
+
+	plan := NewPurgePlan()
+	plan.AddNode(&PurgePlanNode{ID: "env", Kind: "environment", Executor: func() error {
+		order = append(order, "env")
+		return nil
+	}})
+	plan.AddNode(&PurgePlanNode{ID: "service", Kind: "service", DependsOn: []string{"env"}, Executor: func() error {
+		order = append(order, "service")
+		return nil
+	}})
+	plan.execute()
+
+	if len(order) != 2 || order[0] != "env" || order[1] != "service" {
+		t.Errorf("expected service to execute only after its dependency env, got %v", order)
+	}
+	if plan.nodes["env"].Status != purgeNodeStatusDeleted {
+		t.Errorf("expected env to be deleted, got %v", plan.nodes["env"].Status)
+	}
+	if plan.nodes["service"].Status != purgeNodeStatusDeleted {
+		t.Errorf("expected service to be deleted, got %v", plan.nodes["service"].Status)
+	}
+}
+
+func TestPurgePlanExecuteBlocksDependentsOnFailure(t *testing.T) {
+	failure := errors.New("boom")
+
+	plan := NewPurgePlan()
+	plan.AddNode(&PurgePlanNode{ID: "env", Kind: "environment", Executor: func() error {
+		return failure
+	}})
+	plan.AddNode(&PurgePlanNode{ID: "service", Kind: "service", DependsOn: []string{"env"}, Executor: func() error {
+		t.Error("service should never execute once its dependency env failed")
+		return nil
+	}})
+	plan.execute()
+
+	if plan.nodes["env"].Status != purgeNodeStatusFailed {
+		t.Errorf("expected env to be failed, got %v", plan.nodes["env"].Status)
+	}
+	if plan.nodes["env"].Err != failure {
+		t.Errorf("expected env.Err to be the underlying failure, got %v", plan.nodes["env"].Err)
+	}
+	if plan.nodes["service"].Status != purgeNodeStatusBlocked {
+		t.Errorf("expected service to be blocked by its failed dependency, got %v", plan.nodes["service"].Status)
+	}
+}